@@ -0,0 +1,146 @@
+package deepresearch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ReportFormat은 RenderReport가 생성할 수 있는 보고서 형식입니다.
+type ReportFormat string
+
+const (
+	ReportFormatMarkdown ReportFormat = "markdown"
+	ReportFormatHTML     ReportFormat = "html"
+	ReportFormatPDF      ReportFormat = "pdf"
+	ReportFormatDOCX     ReportFormat = "docx"
+)
+
+// ActionPlanStep은 액션 플랜을 구성하는 개별 실행 단계입니다.
+type ActionPlanStep struct {
+	ID              string   `json:"id"`
+	Description     string   `json:"description"`
+	Owner           string   `json:"owner,omitempty"`
+	DependsOn       []string `json:"dependsOn,omitempty"`
+	EstimatedEffort string   `json:"estimatedEffort,omitempty"`
+	// Citations는 이 단계 근거가 된 Result.VisitedUrls의 항목을 가리킵니다.
+	Citations []string `json:"citations,omitempty"`
+}
+
+// ActionPlan은 연구 결과로부터 도출된 실행 계획입니다. 서버는
+// /api/research/{id} 응답의 Result.ActionPlan을 이 구조에 맞춰 채웁니다.
+type ActionPlan struct {
+	Goals          []string         `json:"goals"`
+	Steps          []ActionPlanStep `json:"steps"`
+	SuccessMetrics []string         `json:"successMetrics,omitempty"`
+}
+
+// GetActionPlan은 작업의 액션 플랜을 ActionPlan 구조체로 가져옵니다.
+// Result.ActionPlan의 interface{}를 직접 타입 단언하는 대신 사용하세요.
+func (c *ResearchClient) GetActionPlan(jobID string) (*ActionPlan, error) {
+	return c.GetActionPlanContext(context.Background(), jobID)
+}
+
+// GetActionPlanContext는 GetActionPlan에 ctx를 추가로 받는 버전입니다.
+func (c *ResearchClient) GetActionPlanContext(ctx context.Context, jobID string) (*ActionPlan, error) {
+	status, err := c.GetJobStatusContext(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if status.Result == nil || status.Result.ActionPlan == nil {
+		return nil, fmt.Errorf("job %s has no action plan", jobID)
+	}
+
+	raw, err := json.Marshal(status.Result.ActionPlan)
+	if err != nil {
+		return nil, err
+	}
+
+	var plan ActionPlan
+	if err := json.Unmarshal(raw, &plan); err != nil {
+		return nil, fmt.Errorf("action plan does not match expected structure: %w", err)
+	}
+
+	if err := validateActionPlan(&plan, status.Result.VisitedUrls); err != nil {
+		return nil, fmt.Errorf("action plan failed schema validation: %w", err)
+	}
+
+	return &plan, nil
+}
+
+// validateActionPlan은 ActionPlan이 실제로 쓸 수 있는 형태인지 확인합니다:
+// 목표와 단계가 비어 있지 않은지, 각 단계 ID가 고유한지, dependsOn이 실제
+// 존재하는 단계를 가리키는지, citations가 Result.VisitedUrls에 있는 URL을
+// 가리키는지를 검사합니다. json.Unmarshal은 타입만 확인할 뿐 이런 내용은
+// 검사하지 않습니다.
+func validateActionPlan(plan *ActionPlan, visitedUrls []string) error {
+	if len(plan.Goals) == 0 {
+		return errors.New("action plan has no goals")
+	}
+	if len(plan.Steps) == 0 {
+		return errors.New("action plan has no steps")
+	}
+
+	visited := make(map[string]bool, len(visitedUrls))
+	for _, u := range visitedUrls {
+		visited[u] = true
+	}
+
+	ids := make(map[string]bool, len(plan.Steps))
+	for _, step := range plan.Steps {
+		if step.ID == "" {
+			return errors.New("action plan step is missing an id")
+		}
+		if ids[step.ID] {
+			return fmt.Errorf("action plan has duplicate step id %q", step.ID)
+		}
+		ids[step.ID] = true
+	}
+
+	for _, step := range plan.Steps {
+		for _, dep := range step.DependsOn {
+			if !ids[dep] {
+				return fmt.Errorf("step %q depends on unknown step %q", step.ID, dep)
+			}
+		}
+		for _, citation := range step.Citations {
+			if !visited[citation] {
+				return fmt.Errorf("step %q cites a URL not in VisitedUrls: %q", step.ID, citation)
+			}
+		}
+	}
+
+	return nil
+}
+
+// RenderReport는 보고서를 markdown, html, pdf, docx 중 원하는 형식으로
+// 렌더링하여 받아옵니다.
+func (c *ResearchClient) RenderReport(jobID string, format ReportFormat) ([]byte, error) {
+	return c.RenderReportContext(context.Background(), jobID, format)
+}
+
+// RenderReportContext는 RenderReport에 ctx를 추가로 받는 버전입니다. pdf/docx
+// 렌더링은 서버에서 시간이 걸릴 수 있어 타임아웃이 없는 DataHTTPClient를
+// 사용합니다.
+func (c *ResearchClient) RenderReportContext(ctx context.Context, jobID string, format ReportFormat) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/research/%s/report?format=%s", c.BaseURL, jobID, format), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.DataHTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s, status code: %d", string(body), resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,186 @@
+package deepresearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// ResearchGraphNode는 배치 내 하나의 연구 작업과 그 의존 관계를 나타냅니다.
+// Options.Query에는 "{{jobA.learnings}}"처럼 다른 노드의 ID를 참조하는
+// 템플릿을 쓸 수 있으며, 서버는 DependsOn에 나열된 노드가 끝난 뒤 그
+// 학습/방문 URL을 채워 넣고 이 노드를 실행합니다.
+type ResearchGraphNode struct {
+	ID        string          `json:"id"`
+	Options   ResearchOptions `json:"options"`
+	DependsOn []string        `json:"dependsOn,omitempty"`
+}
+
+// ResearchGraph는 여러 연구 작업을 의존 관계가 있는 DAG로 묶어 제출하기
+// 위한 빌더입니다.
+type ResearchGraph struct {
+	nodes []ResearchGraphNode
+}
+
+// NewResearchGraph는 빈 ResearchGraph를 생성합니다.
+func NewResearchGraph() *ResearchGraph {
+	return &ResearchGraph{}
+}
+
+// AddNode는 id로 식별되는 연구 작업을 그래프에 추가합니다. dependsOn에
+// 나열된 id의 작업이 먼저 완료되어야 이 노드가 실행됩니다.
+func (g *ResearchGraph) AddNode(id string, options ResearchOptions, dependsOn ...string) *ResearchGraph {
+	g.nodes = append(g.nodes, ResearchGraphNode{ID: id, Options: options, DependsOn: dependsOn})
+	return g
+}
+
+// BatchResponse는 배치(또는 그래프) 작업의 상태입니다. 자식 작업들의 상태를
+// 집계합니다.
+type BatchResponse struct {
+	BatchID   string        `json:"batchId"`
+	Status    string        `json:"status"`
+	JobIDs    []string      `json:"jobIds"`
+	Jobs      []JobResponse `json:"jobs,omitempty"`
+	CreatedAt time.Time     `json:"createdAt"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+	Error     string        `json:"error,omitempty"`
+}
+
+// StartBatch는 여러 연구 작업을 한 번의 요청으로 제출합니다. 반환되는
+// JobID 목록은 옵션 순서와 같습니다. 작업 간 의존 관계가 필요하면
+// StartResearchGraph를 사용하세요.
+func (c *ResearchClient) StartBatch(ctx context.Context, options []ResearchOptions) ([]string, error) {
+	reqBody, err := json.Marshal(struct {
+		Jobs []ResearchOptions `json:"jobs"`
+	}{Jobs: options})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/research/batch", c.BaseURL), bytes.NewBuffer(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s, status code: %d", string(body), resp.StatusCode)
+	}
+
+	var result struct {
+		JobIDs []string `json:"jobIds"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result.JobIDs, nil
+}
+
+// StartResearchGraph는 ResearchGraph에 정의된 DAG를 서버에 제출합니다.
+// 서버는 각 노드를 DependsOn이 끝난 뒤 실행하고, 업스트림 노드의 학습과
+// 방문 URL을 다운스트림 노드의 쿼리 템플릿에 채워 넣습니다. 반환되는
+// batchID의 상태는 GetBatchStatus/WaitForBatch로 조회합니다.
+func (c *ResearchClient) StartResearchGraph(ctx context.Context, graph *ResearchGraph) (string, error) {
+	reqBody, err := json.Marshal(struct {
+		Nodes []ResearchGraphNode `json:"nodes"`
+	}{Nodes: graph.nodes})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/research/graph", c.BaseURL), bytes.NewBuffer(reqBody))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted {
+		body, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("API error: %s, status code: %d", string(body), resp.StatusCode)
+	}
+
+	var result struct {
+		BatchID string `json:"batchId"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", err
+	}
+
+	return result.BatchID, nil
+}
+
+// GetBatchStatus는 배치(또는 그래프) 작업의 집계 상태를 조회합니다.
+func (c *ResearchClient) GetBatchStatus(ctx context.Context, batchID string) (*BatchResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/research/batch/%s", c.BaseURL, batchID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s, status code: %d", string(body), resp.StatusCode)
+	}
+
+	var result BatchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// WaitForBatch는 WaitForCompletionContext와 마찬가지로 배치에 속한 모든
+// 작업이 끝날 때까지 pollInterval 간격으로 상태를 확인하며, ctx가
+// 취소되거나 데드라인을 넘기면 즉시 반환합니다.
+func (c *ResearchClient) WaitForBatch(ctx context.Context, batchID string, pollInterval time.Duration) (*BatchResponse, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		status, err := c.GetBatchStatus(ctx, batchID)
+		if err != nil {
+			return nil, err
+		}
+
+		if status.Status == "completed" {
+			return status, nil
+		}
+
+		if status.Status == "failed" {
+			return nil, fmt.Errorf("batch failed: %s", status.Error)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
@@ -2,8 +2,8 @@ package deepresearch
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -12,9 +12,16 @@ import (
 )
 
 // ResearchClient는 딥리서치 API와 통신하는 클라이언트입니다.
+//
+// HTTPClient는 작업 시작/상태 조회처럼 짧게 끝나는 제어 평면 호출에 쓰이고,
+// DataHTTPClient는 보고서 다운로드나 이벤트 구독처럼 오래 걸리거나 호출자가
+// 직접 취소를 제어해야 하는 데이터 평면 호출에 쓰입니다. 각 ...Context 메서드는
+// 전달된 context.Context로 취소할 수 있으며, HTTPClient를 쓰는 호출에는 그에
+// 더해 HTTPClient의 타임아웃(NewClient 기준 10초)이 적용됩니다.
 type ResearchClient struct {
-	BaseURL    string
-	HTTPClient *http.Client
+	BaseURL        string
+	HTTPClient     *http.Client
+	DataHTTPClient *http.Client
 }
 
 // ResearchOptions는 연구 작업 시작에 필요한 옵션입니다.
@@ -26,6 +33,21 @@ type ResearchOptions struct {
 	LogFileName        string `json:"logFileName,omitempty"`
 	ReportFileName     string `json:"reportFileName,omitempty"`
 	ActionPlanFileName string `json:"actionPlanFileName,omitempty"`
+
+	// IdempotencyKey가 설정되면 Idempotency-Key 헤더와 요청 본문에 함께
+	// 실립니다. 같은 키로 StartResearch를 재시도하면 서버는 새 작업을 만드는
+	// 대신 기존 JobID를 반환하므로, 클라이언트 크래시나 중복 제출로 연구
+	// 작업이 중복 실행되는 것을 막을 수 있습니다. StartBatch/
+	// StartResearchGraph로 제출되는 ResearchOptions는 헤더를 개별적으로
+	// 붙일 수 없어 본문 필드로만 전달되니, 배치 안의 각 작업에도 고유한
+	// 키를 지정하세요.
+	IdempotencyKey string `json:"idempotencyKey,omitempty"`
+
+	// Webhook이 설정되면 서버는 Webhook.Events에 나열된 이벤트(비어 있으면
+	// progress, completed, failed 모두)가 생길 때마다 Webhook.URL로 콜백을
+	// 보냅니다. 호출자가 작업 완료를 알기 위해 프로세스를 계속 띄워둔 채
+	// 폴링할 필요가 없습니다.
+	Webhook *WebhookConfig `json:"webhook,omitempty"`
 }
 
 // JobResponse는 작업 상태 응답입니다.
@@ -47,31 +69,45 @@ type Result struct {
 	LogPath        string   `json:"logPath,omitempty"`
 	ActionPlanPath string   `json:"actionPlanPath,omitempty"`
 	Report         string   `json:"report,omitempty"`
-	ActionPlan     interface{} `json:"actionPlan,omitempty"`
+
+	// ActionPlan은 서버가 보낸 원본 액션 플랜입니다. 직접 타입 단언하는
+	// 대신 GetActionPlan을 사용해 ActionPlan 구조체로 받으세요.
+	ActionPlan interface{} `json:"actionPlan,omitempty"`
 }
 
-// NewClient는 새로운 ResearchClient를 생성합니다.
+// NewClient는 새로운 ResearchClient를 생성합니다. 제어 평면 호출에는 10초
+// 타임아웃을 두고, 다운로드/이벤트 구독 등 데이터 평면 호출은 타임아웃 없이
+// 호출자가 넘기는 context.Context로만 취소되도록 합니다.
 func NewClient(baseURL string) *ResearchClient {
 	return &ResearchClient{
 		BaseURL: baseURL,
 		HTTPClient: &http.Client{
 			Timeout: 10 * time.Second,
 		},
+		DataHTTPClient: &http.Client{},
 	}
 }
 
 // StartResearch는 새로운 연구 작업을 시작합니다.
 func (c *ResearchClient) StartResearch(options ResearchOptions) (string, error) {
+	return c.StartResearchContext(context.Background(), options)
+}
+
+// StartResearchContext는 StartResearch에 ctx를 추가로 받는 버전입니다.
+func (c *ResearchClient) StartResearchContext(ctx context.Context, options ResearchOptions) (string, error) {
 	reqBody, err := json.Marshal(options)
 	if err != nil {
 		return "", err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/research", c.BaseURL), bytes.NewBuffer(reqBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/research", c.BaseURL), bytes.NewBuffer(reqBody))
 	if err != nil {
 		return "", err
 	}
 	req.Header.Set("Content-Type", "application/json")
+	if options.IdempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", options.IdempotencyKey)
+	}
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
@@ -79,7 +115,9 @@ func (c *ResearchClient) StartResearch(options ResearchOptions) (string, error)
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusAccepted {
+	// 멱등성 키 재시도 시 서버는 새 작업을 만드는 대신 기존 작업을 200으로
+	// 돌려줄 수 있으므로 202와 200을 모두 성공으로 취급합니다.
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		return "", fmt.Errorf("API error: %s, status code: %d", string(body), resp.StatusCode)
 	}
@@ -98,7 +136,12 @@ func (c *ResearchClient) StartResearch(options ResearchOptions) (string, error)
 
 // GetJobStatus는 작업 상태를 확인합니다.
 func (c *ResearchClient) GetJobStatus(jobID string) (*JobResponse, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/research/%s", c.BaseURL, jobID), nil)
+	return c.GetJobStatusContext(context.Background(), jobID)
+}
+
+// GetJobStatusContext는 GetJobStatus에 ctx를 추가로 받는 버전입니다.
+func (c *ResearchClient) GetJobStatusContext(ctx context.Context, jobID string) (*JobResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/research/%s", c.BaseURL, jobID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -124,27 +167,43 @@ func (c *ResearchClient) GetJobStatus(jobID string) (*JobResponse, error) {
 
 // DownloadReport는 보고서 파일을 다운로드합니다.
 func (c *ResearchClient) DownloadReport(jobID, outputPath string) error {
-	return c.downloadFile(jobID, "report", outputPath)
+	return c.downloadFile(context.Background(), jobID, "report", outputPath)
+}
+
+// DownloadReportContext는 DownloadReport에 ctx를 추가로 받는 버전입니다.
+func (c *ResearchClient) DownloadReportContext(ctx context.Context, jobID, outputPath string) error {
+	return c.downloadFile(ctx, jobID, "report", outputPath)
 }
 
 // DownloadLog는 로그 파일을 다운로드합니다.
 func (c *ResearchClient) DownloadLog(jobID, outputPath string) error {
-	return c.downloadFile(jobID, "log", outputPath)
+	return c.downloadFile(context.Background(), jobID, "log", outputPath)
+}
+
+// DownloadLogContext는 DownloadLog에 ctx를 추가로 받는 버전입니다.
+func (c *ResearchClient) DownloadLogContext(ctx context.Context, jobID, outputPath string) error {
+	return c.downloadFile(ctx, jobID, "log", outputPath)
 }
 
 // DownloadActionPlan은 액션 플랜 파일을 다운로드합니다.
 func (c *ResearchClient) DownloadActionPlan(jobID, outputPath string) error {
-	return c.downloadFile(jobID, "action-plan", outputPath)
+	return c.downloadFile(context.Background(), jobID, "action-plan", outputPath)
 }
 
-// downloadFile은 파일을 다운로드하는 내부 함수입니다.
-func (c *ResearchClient) downloadFile(jobID, fileType, outputPath string) error {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/research/%s/%s", c.BaseURL, jobID, fileType), nil)
+// DownloadActionPlanContext는 DownloadActionPlan에 ctx를 추가로 받는 버전입니다.
+func (c *ResearchClient) DownloadActionPlanContext(ctx context.Context, jobID, outputPath string) error {
+	return c.downloadFile(ctx, jobID, "action-plan", outputPath)
+}
+
+// downloadFile은 파일을 다운로드하는 내부 함수입니다. 큰 파일을 오래 받을 수
+// 있으므로 타임아웃이 없는 DataHTTPClient를 사용하고 취소는 ctx로 합니다.
+func (c *ResearchClient) downloadFile(ctx context.Context, jobID, fileType, outputPath string) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/research/%s/%s", c.BaseURL, jobID, fileType), nil)
 	if err != nil {
 		return err
 	}
 
-	resp, err := c.HTTPClient.Do(req)
+	resp, err := c.DataHTTPClient.Do(req)
 	if err != nil {
 		return err
 	}
@@ -167,13 +226,22 @@ func (c *ResearchClient) downloadFile(jobID, fileType, outputPath string) error
 
 // WaitForCompletion은 작업이 완료될 때까지 대기합니다.
 func (c *ResearchClient) WaitForCompletion(jobID string, pollInterval time.Duration, timeout time.Duration) (*JobResponse, error) {
-	startTime := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return c.WaitForCompletionContext(ctx, jobID, pollInterval)
+}
+
+// WaitForCompletionContext는 WaitForCompletion과 같은 일을 하지만 고정된
+// timeout 인자 대신 ctx가 취소되거나 데드라인을 넘기면 즉시 반환합니다.
+func (c *ResearchClient) WaitForCompletionContext(ctx context.Context, jobID string, pollInterval time.Duration) (*JobResponse, error) {
 	for {
-		if time.Since(startTime) > timeout {
-			return nil, errors.New("timeout waiting for job completion")
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
 		}
 
-		status, err := c.GetJobStatus(jobID)
+		status, err := c.GetJobStatusContext(ctx, jobID)
 		if err != nil {
 			return nil, err
 		}
@@ -186,6 +254,10 @@ func (c *ResearchClient) WaitForCompletion(jobID string, pollInterval time.Durat
 			return nil, fmt.Errorf("job failed: %s", status.Error)
 		}
 
-		time.Sleep(pollInterval)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(pollInterval):
+		}
 	}
-} 
\ No newline at end of file
+}
@@ -0,0 +1,212 @@
+package deepresearch
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+)
+
+// DownloadReportRange는 보고서 파일 중 지정된 바이트 범위만 다운로드하여
+// outputPath에 이어 씁니다. rangeEnd가 0이면 rangeStart부터 파일 끝까지
+// 요청합니다.
+func (c *ResearchClient) DownloadReportRange(jobID, outputPath string, rangeStart, rangeEnd int64) error {
+	return c.downloadFileRange(context.Background(), jobID, "report", outputPath, rangeStart, rangeEnd)
+}
+
+// DownloadReportRangeContext는 DownloadReportRange에 ctx를 추가로 받는 버전입니다.
+func (c *ResearchClient) DownloadReportRangeContext(ctx context.Context, jobID, outputPath string, rangeStart, rangeEnd int64) error {
+	return c.downloadFileRange(ctx, jobID, "report", outputPath, rangeStart, rangeEnd)
+}
+
+// DownloadReportResumable은 outputPath에 이미 받아둔 바이트 수를 확인하고
+// 그 지점부터 Range 요청으로 이어받습니다. 보고서와 로그 파일은 용량이 큰
+// 경우가 많아, 네트워크가 끊겼다고 매번 처음부터 다시 받을 필요가 없습니다.
+func (c *ResearchClient) DownloadReportResumable(jobID, outputPath string) error {
+	return c.DownloadReportResumableContext(context.Background(), jobID, outputPath)
+}
+
+// DownloadReportResumableContext는 DownloadReportResumable에 ctx를 추가로 받는 버전입니다.
+func (c *ResearchClient) DownloadReportResumableContext(ctx context.Context, jobID, outputPath string) error {
+	var offset int64
+	switch info, err := os.Stat(outputPath); {
+	case err == nil:
+		if info.IsDir() {
+			return fmt.Errorf("%s is a directory, not a file", outputPath)
+		}
+		offset = info.Size()
+	case os.IsNotExist(err):
+		offset = 0
+	default:
+		return err
+	}
+
+	return c.downloadFileResumable(ctx, jobID, "report", outputPath, offset)
+}
+
+// downloadFileResumable은 outputPath에 이미 받은 offset 바이트가 있다고
+// 가정하고 나머지를 이어받습니다. 먼저 HEAD로 현재 Content-Length/ETag를
+// 확인해 이미 완전히 받은 파일이면 재요청 없이 성공으로 끝내고, 그렇지
+// 않으면 If-Range로 원격 리소스가 바뀌지 않았는지 확인하면서 Range GET을
+// 보냅니다. 리소스가 바뀌었다면 서버는 206 대신 200과 전체 본문을 돌려주므로
+// 이어받기를 포기하고 처음부터 다시 받습니다. 다운로드가 끝나면 최종 파일
+// 크기를 Content-Length와 비교해 중간에 잘리지 않았는지 확인합니다.
+func (c *ResearchClient) downloadFileResumable(ctx context.Context, jobID, fileType, outputPath string, offset int64) error {
+	url := fmt.Sprintf("%s/api/research/%s/%s", c.BaseURL, jobID, fileType)
+
+	headReq, err := http.NewRequestWithContext(ctx, "HEAD", url, nil)
+	if err != nil {
+		return err
+	}
+
+	headResp, err := c.DataHTTPClient.Do(headReq)
+	if err != nil {
+		return err
+	}
+	headResp.Body.Close()
+
+	if headResp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API error: status code: %d", headResp.StatusCode)
+	}
+
+	total := headResp.ContentLength
+	etag := headResp.Header.Get("ETag")
+
+	if total >= 0 {
+		if offset == total {
+			// 이미 전체를 받아둔 상태이므로 재다운로드 없이 성공으로 끝냅니다.
+			return nil
+		}
+		if offset > total {
+			// 로컬 파일이 원격보다 크다는 것은 리소스가 바뀌었다는 뜻이므로
+			// 처음부터 다시 받습니다.
+			offset = 0
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return err
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		if etag != "" {
+			req.Header.Set("If-Range", etag)
+		}
+	}
+
+	resp, err := c.DataHTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var out *os.File
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		out, err = os.OpenFile(outputPath, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	case http.StatusOK:
+		// If-Range 조건이 맞지 않아 리소스가 바뀐 경우이므로 전체 본문을
+		// 처음부터 새로 씁니다.
+		out, err = os.Create(outputPath)
+		offset = 0
+	default:
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error: %s, status code: %d", string(body), resp.StatusCode)
+	}
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	written, err := io.Copy(out, resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if total >= 0 {
+		if finalSize := offset + written; finalSize != total {
+			return fmt.Errorf("downloaded file size mismatch: got %d bytes, expected %d", finalSize, total)
+		}
+	}
+
+	return nil
+}
+
+// downloadFileRange는 Range 헤더를 사용해 파일의 일부 또는 전체를 받아
+// outputPath에 이어 씁니다. 서버가 Range를 지원하지 않고 206 대신 200을
+// 반환하면 파일을 처음부터 새로 받습니다.
+func (c *ResearchClient) downloadFileRange(ctx context.Context, jobID, fileType, outputPath string, rangeStart, rangeEnd int64) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/research/%s/%s", c.BaseURL, jobID, fileType), nil)
+	if err != nil {
+		return err
+	}
+	if rangeStart > 0 || rangeEnd > 0 {
+		if rangeEnd > 0 {
+			req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", rangeStart, rangeEnd))
+		} else {
+			req.Header.Set("Range", fmt.Sprintf("bytes=%d-", rangeStart))
+		}
+	}
+
+	resp, err := c.DataHTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var out *os.File
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		out, err = os.OpenFile(outputPath, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	case http.StatusOK:
+		out, err = os.Create(outputPath)
+	default:
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error: %s, status code: %d", string(body), resp.StatusCode)
+	}
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, resp.Body)
+	return err
+}
+
+// DownloadBundle은 보고서, 로그, 액션 플랜, 방문 URL 목록을 하나의 ZIP으로
+// 묶어 한 번의 요청으로 내려받습니다. 아티팩트를 개별 엔드포인트로 나눠
+// 받는 DownloadReport/DownloadLog/DownloadActionPlan과 달리, 전체를 한
+// 번에 보관하고 싶을 때 사용합니다.
+func (c *ResearchClient) DownloadBundle(jobID, outputPath string) error {
+	return c.DownloadBundleContext(context.Background(), jobID, outputPath)
+}
+
+// DownloadBundleContext는 DownloadBundle에 ctx를 추가로 받는 버전입니다.
+func (c *ResearchClient) DownloadBundleContext(ctx context.Context, jobID, outputPath string) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/research/%s/bundle", c.BaseURL, jobID), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.DataHTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error: %s, status code: %d", string(body), resp.StatusCode)
+	}
+
+	out, err := os.Create(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, resp.Body)
+	return err
+}
@@ -0,0 +1,108 @@
+package deepresearch
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// JobEventType은 SubscribeJobEvents가 전달하는 이벤트의 종류입니다.
+type JobEventType string
+
+const (
+	JobEventQueryPlanning     JobEventType = "query-planning"
+	JobEventURLFetched        JobEventType = "url-fetched"
+	JobEventLearningExtracted JobEventType = "learning-extracted"
+	JobEventReportWriting     JobEventType = "report-writing"
+	JobEventCompleted         JobEventType = "completed"
+	JobEventFailed            JobEventType = "failed"
+
+	// JobEventStreamError는 SubscribeJobEvents가 SSE 스트림을 더 이상 읽을 수
+	// 없을 때(스캔 에러, 한 줄이 버퍼 한도를 넘는 경우 등) 채널이 닫히기
+	// 직전에 보내는 마지막 이벤트입니다. 이 이벤트 없이 채널이 닫히면
+	// 서버가 스트림을 정상 종료했거나 ctx가 취소된 것입니다.
+	JobEventStreamError JobEventType = "stream-error"
+)
+
+// maxSSELineSize는 SSE 한 줄(예: learning-extracted의 data 페이로드)이 가질
+// 수 있는 최대 길이입니다. bufio.Scanner의 기본 64KB 한도로는 큰 이벤트
+// 페이로드가 잘려 스트림이 조용히 끊길 수 있어 넉넉하게 잡습니다.
+const maxSSELineSize = 10 * 1024 * 1024
+
+// JobEvent는 작업이 진행되는 동안 서버가 전달하는 단일 진행 이벤트입니다.
+type JobEvent struct {
+	Type    JobEventType    `json:"type"`
+	JobID   string          `json:"jobId"`
+	Message string          `json:"message,omitempty"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// SubscribeJobEvents는 /api/research/{id}/events에 SSE로 접속하여 작업의
+// 세부 진행 이벤트(쿼리 계획, URL별 수집, 학습 추출, 보고서 작성 등)를
+// 채널로 전달합니다. pollInterval 간격으로 폴링하는 WaitForCompletion과 달리
+// 서버가 보내는 즉시 이벤트를 받을 수 있어 CLI 진행률 표시 등에 적합합니다.
+// ctx가 취소되거나 서버가 연결을 닫으면 채널이 닫힙니다. 스트림을 읽다가
+// 에러가 나면(연결 끊김, 한 줄이 버퍼 한도를 넘는 경우 등) 채널이 닫히기
+// 전에 JobEventStreamError 이벤트를 한 번 보내 호출자가 끊긴 이유를 알 수
+// 있게 합니다.
+func (c *ResearchClient) SubscribeJobEvents(ctx context.Context, jobID string) (<-chan JobEvent, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/research/%s/events", c.BaseURL, jobID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := c.DataHTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		return nil, fmt.Errorf("API error: %s, status code: %d", string(body), resp.StatusCode)
+	}
+
+	events := make(chan JobEvent)
+	go func() {
+		defer close(events)
+		defer resp.Body.Close()
+
+		scanner := bufio.NewScanner(resp.Body)
+		scanner.Buffer(make([]byte, 0, 64*1024), maxSSELineSize)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if !strings.HasPrefix(line, "data:") {
+				continue
+			}
+
+			payload := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+			if payload == "" {
+				continue
+			}
+
+			var event JobEvent
+			if err := json.Unmarshal([]byte(payload), &event); err != nil {
+				continue
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			select {
+			case events <- JobEvent{Type: JobEventStreamError, JobID: jobID, Message: err.Error()}:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	return events, nil
+}
@@ -0,0 +1,92 @@
+package deepresearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// JobFilter는 ListJobs 호출 시 적용할 조건입니다. 비어 있는 필드는
+// 무시됩니다.
+//
+// 작업 상태와 진행률을 재시작 후에도 유지하는 영속 저장소(BoltDB/Redis 등)는
+// 서버 구현에 속하며, 이 클라이언트는 서버가 그런 저장소를 두고 있다고
+// 가정하고 그 위에서 동작하는 API만 제공합니다.
+type JobFilter struct {
+	Status string
+	Limit  int
+}
+
+// ListJobs는 서버에 등록된 작업 목록을 조회합니다.
+func (c *ResearchClient) ListJobs(filter JobFilter) ([]JobResponse, error) {
+	return c.ListJobsContext(context.Background(), filter)
+}
+
+// ListJobsContext는 ListJobs에 ctx를 추가로 받는 버전입니다.
+func (c *ResearchClient) ListJobsContext(ctx context.Context, filter JobFilter) ([]JobResponse, error) {
+	q := url.Values{}
+	if filter.Status != "" {
+		q.Set("status", filter.Status)
+	}
+	if filter.Limit > 0 {
+		q.Set("limit", fmt.Sprintf("%d", filter.Limit))
+	}
+
+	reqURL := fmt.Sprintf("%s/api/research", c.BaseURL)
+	if encoded := q.Encode(); encoded != "" {
+		reqURL = fmt.Sprintf("%s?%s", reqURL, encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s, status code: %d", string(body), resp.StatusCode)
+	}
+
+	var result []JobResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// CancelJob은 진행 중인 작업을 취소합니다. 이미 완료되었거나 실패한 작업을
+// 취소하려 하면 서버가 에러를 반환합니다.
+func (c *ResearchClient) CancelJob(jobID string) error {
+	return c.CancelJobContext(context.Background(), jobID)
+}
+
+// CancelJobContext는 CancelJob에 ctx를 추가로 받는 버전입니다.
+func (c *ResearchClient) CancelJobContext(ctx context.Context, jobID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/research/%s", c.BaseURL, jobID), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error: %s, status code: %d", string(body), resp.StatusCode)
+	}
+
+	return nil
+}
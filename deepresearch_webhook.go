@@ -0,0 +1,63 @@
+package deepresearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// WebhookConfig는 작업 진행 상황을 콜백으로 받기 위한 설정입니다. Secret이
+// 설정되면 서버는 요청 본문에 대한 HMAC-SHA256 서명을 X-Signature 헤더에
+// "sha256=<hex>" 형식으로 담아 보내며, 호출자는 같은 Secret으로 서명을
+// 검증해 요청이 위조되지 않았는지 확인할 수 있습니다. Events가 비어 있으면
+// progress, completed, failed 이벤트 모두 전송됩니다.
+type WebhookConfig struct {
+	URL    string   `json:"url"`
+	Secret string   `json:"secret,omitempty"`
+	Events []string `json:"events,omitempty"`
+}
+
+// WebhookDelivery는 웹훅 전송 시도 한 건의 기록입니다. 서버는 전송이
+// 실패하면 지수 백오프로 재시도하며, 시도마다 하나씩 기록을 남깁니다.
+type WebhookDelivery struct {
+	Event       string    `json:"event"`
+	URL         string    `json:"url"`
+	Attempt     int       `json:"attempt"`
+	StatusCode  int       `json:"statusCode,omitempty"`
+	DeliveredAt time.Time `json:"deliveredAt"`
+	Error       string    `json:"error,omitempty"`
+}
+
+// GetWebhookDeliveries는 작업에 등록된 웹훅의 전송 시도 내역을 조회합니다.
+func (c *ResearchClient) GetWebhookDeliveries(jobID string) ([]WebhookDelivery, error) {
+	return c.GetWebhookDeliveriesContext(context.Background(), jobID)
+}
+
+// GetWebhookDeliveriesContext는 GetWebhookDeliveries에 ctx를 추가로 받는 버전입니다.
+func (c *ResearchClient) GetWebhookDeliveriesContext(ctx context.Context, jobID string) ([]WebhookDelivery, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/research/%s/webhook-deliveries", c.BaseURL, jobID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s, status code: %d", string(body), resp.StatusCode)
+	}
+
+	var result []WebhookDelivery
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}